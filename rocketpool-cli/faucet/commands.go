@@ -18,7 +18,13 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "status",
 				Aliases:   []string{"s"},
 				Usage:     "Get the faucet's status",
-				UsageText: "rocketpool faucet status",
+				UsageText: "rocketpool faucet status [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print the faucet status as JSON; errors are printed as plain text and exit with a non-zero code",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
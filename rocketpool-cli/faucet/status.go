@@ -1,8 +1,10 @@
 package faucet
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
@@ -12,6 +14,18 @@ import (
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
+// FaucetStatusResponse with amounts in wei as decimal strings
+type faucetStatusJson struct {
+	Status             string `json:"status"`
+	Error              string `json:"error"`
+	Balance            string `json:"balance"`
+	Allowance          string `json:"allowance"`
+	WithdrawableAmount string `json:"withdrawableAmount"`
+	WithdrawalFee      string `json:"withdrawalFee"`
+	ResetsInBlocks     uint64 `json:"resetsInBlocks"`
+	ResetsInSeconds    uint64 `json:"resetsInSeconds"`
+}
+
 func getStatus(c *cli.Context) error {
 
 	// Get RP client
@@ -22,7 +36,11 @@ func getStatus(c *cli.Context) error {
 	defer rp.Close()
 
 	// Check and assign the EC status
-	err = cliutils.CheckClientStatus(rp)
+	if c.Bool("json") {
+		err = cliutils.CheckClientStatusToStderr(rp)
+	} else {
+		err = cliutils.CheckClientStatus(rp)
+	}
 	if err != nil {
 		return err
 	}
@@ -33,14 +51,49 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print status as JSON
+	if c.Bool("json") {
+		bytes, err := json.MarshalIndent(faucetStatusJson{
+			Status:             status.Status,
+			Error:              status.Error,
+			Balance:            amountString(status.Balance),
+			Allowance:          amountString(status.Allowance),
+			WithdrawableAmount: amountString(status.WithdrawableAmount),
+			WithdrawalFee:      amountString(status.WithdrawalFee),
+			ResetsInBlocks:     status.ResetsInBlocks,
+			ResetsInSeconds:    status.ResetsInSeconds,
+		}, "", "    ")
+		if err != nil {
+			return fmt.Errorf("Could not encode faucet status: %w", err)
+		}
+		fmt.Println(string(bytes))
+		return nil
+	}
+
 	// Print status & return
-	fmt.Printf("The faucet has a balance of %.6f legacy RPL.\n", math.RoundDown(eth.WeiToEth(status.Balance), 6))
-	if status.WithdrawableAmount.Cmp(big.NewInt(0)) > 0 {
-		fmt.Printf("You can withdraw %.6f legacy RPL (requires a %.6f GoETH fee)!\n", math.RoundDown(eth.WeiToEth(status.WithdrawableAmount), 6), math.RoundDown(eth.WeiToEth(status.WithdrawalFee), 6))
+	fmt.Printf("The faucet has a balance of %.6f legacy RPL.\n", formatAmount(status.Balance))
+	if status.WithdrawableAmount != nil && status.WithdrawableAmount.Cmp(big.NewInt(0)) > 0 {
+		fmt.Printf("You can withdraw %.6f legacy RPL (requires a %.6f GoETH fee)!\n", formatAmount(status.WithdrawableAmount), formatAmount(status.WithdrawalFee))
 	} else {
 		fmt.Println("You cannot withdraw legacy RPL right now.")
 	}
-	fmt.Printf("Allowances reset in %d blocks.\n", status.ResetsInBlocks)
+	fmt.Printf("Allowances reset in %d blocks (approximately %s).\n", status.ResetsInBlocks, time.Duration(status.ResetsInSeconds)*time.Second)
 	return nil
 
 }
+
+// Get an amount in wei as a rounded token value, treating a missing amount as zero
+func formatAmount(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+	return math.RoundDown(eth.WeiToEth(amount), 6)
+}
+
+// Get an amount in wei as a decimal string, treating a missing amount as zero
+func amountString(amount *big.Int) string {
+	if amount == nil {
+		return "0"
+	}
+	return amount.String()
+}
@@ -3,6 +3,7 @@ package faucet
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
@@ -10,6 +11,9 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
+// Settings
+const SecondsPerBlock uint64 = 12
+
 func getStatus(c *cli.Context) (*api.FaucetStatusResponse, error) {
 
 	// Get services
@@ -41,36 +45,47 @@ func getStatus(c *cli.Context) (*api.FaucetStatusResponse, error) {
 		return nil, err
 	}
 
+	// Get the current block
+	header, err := ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+	currentBlock := header.Number.Uint64()
+
+	// Initialize call options
+	opts := &bind.CallOpts{
+		BlockNumber: header.Number,
+	}
+
 	// Data
 	var wg errgroup.Group
 	var currentPeriodStartBlock uint64
 	var withdrawalPeriodBlocks uint64
-	var currentBlock uint64
 
 	// Get faucet balance
 	wg.Go(func() error {
 		var err error
-		response.Balance, err = f.GetBalance(nil)
+		response.Balance, err = f.GetBalance(opts)
 		return err
 	})
 
 	// Get allowance
 	wg.Go(func() error {
 		var err error
-		response.Allowance, err = f.GetAllowanceFor(nil, nodeAccount.Address)
+		response.Allowance, err = f.GetAllowanceFor(opts, nodeAccount.Address)
 		return err
 	})
 
 	// Get withdrawal fee
 	wg.Go(func() error {
 		var err error
-		response.WithdrawalFee, err = f.WithdrawalFee(nil)
+		response.WithdrawalFee, err = f.WithdrawalFee(opts)
 		return err
 	})
 
 	// Get current withdrawal period start block
 	wg.Go(func() error {
-		withdrawalPeriodStart, err := f.GetWithdrawalPeriodStart(nil)
+		withdrawalPeriodStart, err := f.GetWithdrawalPeriodStart(opts)
 		if err == nil {
 			currentPeriodStartBlock = withdrawalPeriodStart.Uint64()
 		}
@@ -79,22 +94,13 @@ func getStatus(c *cli.Context) (*api.FaucetStatusResponse, error) {
 
 	// Get withdrawal period
 	wg.Go(func() error {
-		withdrawalPeriod, err := f.WithdrawalPeriod(nil)
+		withdrawalPeriod, err := f.WithdrawalPeriod(opts)
 		if err == nil {
 			withdrawalPeriodBlocks = withdrawalPeriod.Uint64()
 		}
 		return err
 	})
 
-	// Get current block
-	wg.Go(func() error {
-		header, err := ec.HeaderByNumber(context.Background(), nil)
-		if err == nil {
-			currentBlock = header.Number.Uint64()
-		}
-		return err
-	})
-
 	// Wait for data
 	if err := wg.Wait(); err != nil {
 		return nil, err
@@ -108,7 +114,11 @@ func getStatus(c *cli.Context) (*api.FaucetStatusResponse, error) {
 	}
 
 	// Get reset block
-	response.ResetsInBlocks = (currentPeriodStartBlock + withdrawalPeriodBlocks) - currentBlock
+	resetBlock := currentPeriodStartBlock + withdrawalPeriodBlocks
+	if currentBlock < resetBlock {
+		response.ResetsInBlocks = resetBlock - currentBlock
+	}
+	response.ResetsInSeconds = response.ResetsInBlocks * SecondsPerBlock
 
 	// Return response
 	return &response, nil
@@ -0,0 +1,60 @@
+package collectors
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Represents the collector for the watchtower heartbeat metrics
+type HeartbeatCollector struct {
+
+	// The time of the latest heartbeat
+	lastHeartbeatTimeDesc *prometheus.Desc
+
+	// The time the task loop last finished
+	lastTaskLoopTimeDesc *prometheus.Desc
+
+	// Counters
+	LastHeartbeatTime float64
+	LastTaskLoopTime  float64
+
+	// Mutex
+	UpdateLock sync.Mutex
+}
+
+// Create a new HeartbeatCollector instance
+func NewHeartbeatCollector() *HeartbeatCollector {
+	subsystem := "watchtower"
+	return &HeartbeatCollector{
+		lastHeartbeatTimeDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_heartbeat_time"),
+			"The time of the latest watchtower heartbeat",
+			nil, nil,
+		),
+		lastTaskLoopTimeDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_task_loop_time"),
+			"The time the watchtower task loop last finished",
+			nil, nil,
+		),
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *HeartbeatCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.lastHeartbeatTimeDesc
+	channel <- collector.lastTaskLoopTimeDesc
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *HeartbeatCollector) Collect(channel chan<- prometheus.Metric) {
+
+	// Sync
+	collector.UpdateLock.Lock()
+	defer collector.UpdateLock.Unlock()
+
+	// Update all of the metrics
+	channel <- prometheus.MustNewConstMetric(
+		collector.lastHeartbeatTimeDesc, prometheus.GaugeValue, collector.LastHeartbeatTime)
+	channel <- prometheus.MustNewConstMetric(
+		collector.lastTaskLoopTimeDesc, prometheus.GaugeValue, collector.LastTaskLoopTime)
+
+}
@@ -0,0 +1,116 @@
+package watchtower
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// Watchtower health status
+type healthStatus struct {
+	EcSynced        bool      `json:"ecSynced"`
+	BeaconConnected bool      `json:"beaconConnected"`
+	Trusted         bool      `json:"trusted"`
+	LastCheckTime   time.Time `json:"lastCheckTime"`
+}
+
+// Watchtower health check
+type healthCheck struct {
+	c      *cli.Context
+	w      *wallet.Wallet
+	rp     *rocketpool.RocketPool
+	status healthStatus
+	lock   sync.Mutex
+}
+
+// Create watchtower health check
+func newHealthCheck(c *cli.Context) (*healthCheck, error) {
+
+	// Get services
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return health check
+	return &healthCheck{
+		c:  c,
+		w:  w,
+		rp: rp,
+	}, nil
+
+}
+
+// Check the clients and the node's trusted status, and record the results for the health endpoint
+func (h *healthCheck) run() error {
+
+	status := healthStatus{}
+	defer func() {
+		status.LastCheckTime = time.Now()
+		h.setStatus(status)
+	}()
+
+	// Check the EC status
+	if err := services.WaitEthClientSynced(h.c, false); err != nil { // Force refresh the primary / fallback EC status
+		return err
+	}
+	status.EcSynced = true
+
+	// Check the BC status
+	if err := services.WaitBeaconClientSynced(h.c, false); err != nil { // Force refresh the primary / fallback BC status
+		return err
+	}
+	status.BeaconConnected = true
+
+	// Get node account
+	nodeAccount, err := h.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Check node trusted status
+	nodeTrusted, err := trustednode.GetMemberExists(h.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	status.Trusted = nodeTrusted
+
+	return nil
+
+}
+
+// Set the latest health status
+func (h *healthCheck) setStatus(status healthStatus) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.status = status
+}
+
+// Serve the latest health status, with a 503 status code if the watchtower is not healthy
+func (h *healthCheck) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	h.lock.Lock()
+	status := h.status
+	h.lock.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if status.EcSynced && status.BeaconConnected && status.Trusted {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+
+}
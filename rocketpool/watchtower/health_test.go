@@ -0,0 +1,69 @@
+package watchtower
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthCheckServeHTTP(t *testing.T) {
+
+	lastCheckTime := time.Unix(1660000000, 0).UTC()
+	tests := []struct {
+		name         string
+		status       healthStatus
+		expectedCode int
+	}{
+		{
+			name:         "no checks yet",
+			status:       healthStatus{},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "healthy",
+			status:       healthStatus{EcSynced: true, BeaconConnected: true, Trusted: true, LastCheckTime: lastCheckTime},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "EC not synced",
+			status:       healthStatus{EcSynced: false, BeaconConnected: true, Trusted: true, LastCheckTime: lastCheckTime},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "beacon not connected",
+			status:       healthStatus{EcSynced: true, BeaconConnected: false, Trusted: true, LastCheckTime: lastCheckTime},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:         "not trusted",
+			status:       healthStatus{EcSynced: true, BeaconConnected: true, Trusted: false, LastCheckTime: lastCheckTime},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			h := &healthCheck{}
+			h.setStatus(test.status)
+
+			recorder := httptest.NewRecorder()
+			h.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+			if recorder.Code != test.expectedCode {
+				t.Errorf("Expected status code %d, got %d", test.expectedCode, recorder.Code)
+			}
+			var status healthStatus
+			if err := json.Unmarshal(recorder.Body.Bytes(), &status); err != nil {
+				t.Fatalf("Error decoding health status: %s", err)
+			}
+			if status != test.status {
+				t.Errorf("Expected health status %+v, got %+v", test.status, status)
+			}
+
+		})
+	}
+
+}
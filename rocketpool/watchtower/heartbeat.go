@@ -0,0 +1,66 @@
+package watchtower
+
+import (
+	"time"
+
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Watchtower heartbeat
+type heartbeat struct {
+	log       log.ColorLogger
+	collector *collectors.HeartbeatCollector
+	interval  time.Duration
+	after     func(time.Duration) <-chan time.Time
+}
+
+// Create watchtower heartbeat
+func newHeartbeat(logger log.ColorLogger, collector *collectors.HeartbeatCollector, interval time.Duration) *heartbeat {
+	return &heartbeat{
+		log:       logger,
+		collector: collector,
+		interval:  interval,
+		after:     time.After,
+	}
+}
+
+// Log a heartbeat and update the last heartbeat metric at each interval
+func (h *heartbeat) run() {
+
+	// Return if the heartbeat is disabled
+	if h.interval == 0 {
+		return
+	}
+
+	for {
+		h.beat(<-h.after(h.interval))
+	}
+
+}
+
+// Record that the task loop has finished
+func (h *heartbeat) taskLoopFinished(now time.Time) {
+	h.collector.UpdateLock.Lock()
+	defer h.collector.UpdateLock.Unlock()
+	h.collector.LastTaskLoopTime = float64(now.Unix())
+}
+
+// Log a heartbeat
+func (h *heartbeat) beat(now time.Time) {
+
+	// Update the metrics
+	h.collector.UpdateLock.Lock()
+	h.collector.LastHeartbeatTime = float64(now.Unix())
+	lastTaskLoopTime := h.collector.LastTaskLoopTime
+	h.collector.UpdateLock.Unlock()
+
+	// Log the status
+	if lastTaskLoopTime == 0 {
+		h.log.Println("Heartbeat: watchtower is running, the task loop has not finished yet.")
+		return
+	}
+	sinceTaskLoop := now.Sub(time.Unix(int64(lastTaskLoopTime), 0))
+	h.log.Printlnf("Heartbeat: watchtower is running, the task loop last finished %s ago.", sinceTaskLoop)
+
+}
@@ -0,0 +1,68 @@
+package watchtower
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+func TestHeartbeatInterval(t *testing.T) {
+
+	interval := 30 * time.Second
+	collector := collectors.NewHeartbeatCollector()
+	h := newHeartbeat(log.NewColorLogger(color.FgWhite), collector, interval)
+
+	// Replace the clock with one the test controls
+	requested := make(chan time.Duration)
+	ticks := make(chan time.Time)
+	h.after = func(d time.Duration) <-chan time.Time {
+		requested <- d
+		return ticks
+	}
+	go h.run()
+
+	start := time.Unix(1660000000, 0)
+	for i := 0; i < 3; i++ {
+
+		// Each wait must be for the configured interval
+		if d := <-requested; d != interval {
+			t.Fatalf("Expected heartbeat to wait %s, waited %s", interval, d)
+		}
+
+		// The previous tick must have been recorded before waiting again
+		if i > 0 {
+			expected := float64(start.Add(time.Duration(i) * interval).Unix())
+			collector.UpdateLock.Lock()
+			lastHeartbeatTime := collector.LastHeartbeatTime
+			collector.UpdateLock.Unlock()
+			if lastHeartbeatTime != expected {
+				t.Fatalf("Expected last heartbeat time %.0f, got %.0f", expected, lastHeartbeatTime)
+			}
+		}
+
+		ticks <- start.Add(time.Duration(i+1) * interval)
+
+	}
+
+}
+
+func TestHeartbeatDisabled(t *testing.T) {
+
+	collector := collectors.NewHeartbeatCollector()
+	h := newHeartbeat(log.NewColorLogger(color.FgWhite), collector, 0)
+	h.after = func(d time.Duration) <-chan time.Time {
+		t.Fatalf("Expected disabled heartbeat not to wait, waited %s", d)
+		return nil
+	}
+
+	// Run returns immediately when the heartbeat is disabled
+	h.run()
+	if collector.LastHeartbeatTime != 0 {
+		t.Errorf("Expected no heartbeat, got one at %.0f", collector.LastHeartbeatTime)
+	}
+
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *collectors.ScrubCollector) error {
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *collectors.ScrubCollector, heartbeatCollector *collectors.HeartbeatCollector, health *healthCheck) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -28,6 +28,7 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *co
 	// Set up Prometheus
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(scrubCollector)
+	registry.MustRegister(heartbeatCollector)
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 
 	// Start the HTTP server
@@ -36,6 +37,7 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, scrubCollector *co
 	logger.Printlnf("Starting metrics exporter on %s:%d.", metricsAddress, metricsPort)
 	metricsPath := "/metrics"
 	http.Handle(metricsPath, handler)
+	http.Handle("/health", health)
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte(`<html>
             <head><title>Rocket Pool Watchtower Metrics Exporter</title></head>
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/prysmaticlabs/prysm/v3/beacon-chain/core/signing"
 	prdeposit "github.com/prysmaticlabs/prysm/v3/contracts/deposit"
 	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
 	"github.com/rocket-pool/rocketpool-go/minipool"
@@ -27,7 +26,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
-	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 // Settings
@@ -398,7 +397,7 @@ func (t *submitScrubMinipools) getEth1SearchArtifacts() error {
 	if err != nil {
 		return err
 	}
-	depositDomain, err := signing.ComputeDomain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot)
+	depositDomain, err := validator.GetDepositDomain(eth2Config)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package watchtower
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -29,14 +30,18 @@ import (
 // Settings
 const MinipoolWithdrawableDetailsBatchSize = 20
 
+// Returned by checkWithdrawableBalance when the beacon client reports an empty balance
+var errZeroWithdrawableBalance = errors.New("beacon client reported a validator balance of 0")
+
 // Submit withdrawable minipools task
 type submitWithdrawableMinipools struct {
-	c   *cli.Context
-	log log.ColorLogger
-	cfg *config.RocketPoolConfig
-	w   *wallet.Wallet
-	rp  *rocketpool.RocketPool
-	bc  beacon.Client
+	c      *cli.Context
+	log    log.ColorLogger
+	errLog log.ColorLogger
+	cfg    *config.RocketPoolConfig
+	w      *wallet.Wallet
+	rp     *rocketpool.RocketPool
+	bc     beacon.Client
 }
 
 // Withdrawable minipool info
@@ -48,7 +53,7 @@ type minipoolWithdrawableDetails struct {
 }
 
 // Create submit withdrawable minipools task
-func newSubmitWithdrawableMinipools(c *cli.Context, logger log.ColorLogger) (*submitWithdrawableMinipools, error) {
+func newSubmitWithdrawableMinipools(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitWithdrawableMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -70,12 +75,13 @@ func newSubmitWithdrawableMinipools(c *cli.Context, logger log.ColorLogger) (*su
 
 	// Return task
 	return &submitWithdrawableMinipools{
-		c:   c,
-		log: logger,
-		cfg: cfg,
-		w:   w,
-		rp:  rp,
-		bc:  bc,
+		c:      c,
+		log:    logger,
+		errLog: errorLogger,
+		cfg:    cfg,
+		w:      w,
+		rp:     rp,
+		bc:     bc,
 	}, nil
 
 }
@@ -294,6 +300,25 @@ func (t *submitWithdrawableMinipools) getMinipoolWithdrawableDetails(nodeAddress
 		return minipoolWithdrawableDetails{}, nil
 	}
 
+	// Check for existing node submission
+	nodeSubmittedMinipool, err := t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("minipool.withdrawable.submitted.node"), nodeAddress.Bytes(), minipoolAddress.Bytes()))
+	if err != nil {
+		return minipoolWithdrawableDetails{}, err
+	}
+	if nodeSubmittedMinipool {
+		return minipoolWithdrawableDetails{}, nil
+	}
+
+	// Refuse to submit missing or implausibly large balances
+	if err := checkWithdrawableBalance(validator.Balance, t.cfg.Smartnode.MaxMinipoolWithdrawableBalance.Value.(float64)); err != nil {
+		if err == errZeroWithdrawableBalance {
+			t.log.Printlnf("WARNING: minipool %s: %s, its withdrawable status will not be submitted.", minipoolAddress.Hex(), err)
+		} else {
+			t.errLog.Printlnf("*** CRITICAL: minipool %s: %s. Its withdrawable status will not be submitted. ***", minipoolAddress.Hex(), err)
+		}
+		return minipoolWithdrawableDetails{}, nil
+	}
+
 	// Get start epoch for node balance calculation
 	startEpoch := eth2.EpochAt(eth2Config, userDepositTime)
 	if startEpoch < validator.ActivationEpoch {
@@ -311,15 +336,6 @@ func (t *submitWithdrawableMinipools) getMinipoolWithdrawableDetails(nodeAddress
 	startBalance := eth.GweiToWei(activationBalance + (float64(validator.Balance)-activationBalance)*float64(startEpoch-validator.ActivationEpoch)/float64(beaconHead.FinalizedEpoch-validator.ActivationEpoch))
 	endBalance := eth.GweiToWei(float64(validator.Balance))
 
-	// Check for existing node submission
-	nodeSubmittedMinipool, err := t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("minipool.withdrawable.submitted.node"), nodeAddress.Bytes(), minipoolAddress.Bytes()))
-	if err != nil {
-		return minipoolWithdrawableDetails{}, err
-	}
-	if nodeSubmittedMinipool {
-		return minipoolWithdrawableDetails{}, nil
-	}
-
 	// Get the current ETH balance
 	ethBalance, err := t.rp.Client.BalanceAt(context.Background(), minipoolAddress, nil)
 	if err != nil {
@@ -349,6 +365,18 @@ func (t *submitWithdrawableMinipools) getMinipoolWithdrawableDetails(nodeAddress
 
 }
 
+// Check that a beacon-reported validator balance is plausible enough to submit
+func checkWithdrawableBalance(balanceGwei uint64, maxBalanceEth float64) error {
+	if balanceGwei == 0 {
+		return errZeroWithdrawableBalance
+	}
+	balance := eth.GweiToWei(float64(balanceGwei))
+	if balance.Cmp(eth.EthToWei(maxBalanceEth)) > 0 {
+		return fmt.Errorf("validator balance of %.9f ETH exceeds the maximum of %.6f ETH", eth2.GweiToEth(balanceGwei), maxBalanceEth)
+	}
+	return nil
+}
+
 // Submit minipool withdrawable status
 func (t *submitWithdrawableMinipools) submitWithdrawableMinipool(details minipoolWithdrawableDetails) error {
 
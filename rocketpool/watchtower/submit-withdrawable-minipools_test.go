@@ -0,0 +1,52 @@
+package watchtower
+
+import (
+	"testing"
+)
+
+func TestCheckWithdrawableBalance(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		balanceGwei uint64
+		expectError bool
+	}{
+		{
+			name:        "zero",
+			balanceGwei: 0,
+			expectError: true,
+		},
+		{
+			name:        "32 ETH",
+			balanceGwei: 32000000000,
+			expectError: false,
+		},
+		{
+			name:        "exactly 40 ETH",
+			balanceGwei: 40000000000,
+			expectError: false,
+		},
+		{
+			name:        "40 ETH + 1 gwei",
+			balanceGwei: 40000000001,
+			expectError: true,
+		},
+		{
+			name:        "1000 ETH",
+			balanceGwei: 1000000000000,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkWithdrawableBalance(test.balanceGwei, 40)
+			if test.expectError && err == nil {
+				t.Errorf("Expected balance of %d gwei to be rejected", test.balanceGwei)
+			} else if !test.expectError && err != nil {
+				t.Errorf("Expected balance of %d gwei to be accepted, got error: %s", test.balanceGwei, err)
+			}
+		})
+	}
+
+}
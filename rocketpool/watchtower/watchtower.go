@@ -36,6 +36,7 @@ const (
 	SubmitRewardsTreeColor           = color.FgHiCyan
 	WarningColor                     = color.FgYellow
 	ProcessPenaltiesColor            = color.FgHiMagenta
+	HeartbeatColor                   = color.FgHiWhite
 )
 
 // Register watchtower command
@@ -61,8 +62,15 @@ func run(c *cli.Context) error {
 		return err
 	}
 
-	// Initialize the scrub metrics reporter
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+
+	// Initialize the metrics reporters
 	scrubCollector := collectors.NewScrubCollector()
+	heartbeatCollector := collectors.NewHeartbeatCollector()
 
 	// Initialize error logger
 	errorLog := log.NewColorLogger(ErrorColor)
@@ -80,7 +88,7 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during network balances check: %w", err)
 	}
-	submitWithdrawableMinipools, err := newSubmitWithdrawableMinipools(c, log.NewColorLogger(SubmitWithdrawableMinipoolsColor))
+	submitWithdrawableMinipools, err := newSubmitWithdrawableMinipools(c, log.NewColorLogger(SubmitWithdrawableMinipoolsColor), errorLog)
 	if err != nil {
 		return fmt.Errorf("error during withdrawable minipools check: %w", err)
 	}
@@ -109,12 +117,22 @@ func run(c *cli.Context) error {
 		return fmt.Errorf("error during manual tree generation check: %w", err)
 	}
 
+	// Initialize the health check
+	health, err := newHealthCheck(c)
+	if err != nil {
+		return fmt.Errorf("error during health check: %w", err)
+	}
+
+	// Initialize the heartbeat
+	heartbeatInterval := time.Duration(cfg.Smartnode.WatchtowerHeartbeatInterval.Value.(uint64)) * time.Second
+	heartbeat := newHeartbeat(log.NewColorLogger(HeartbeatColor), heartbeatCollector, heartbeatInterval)
+
 	intervalDelta := maxTasksInterval - minTasksInterval
 	secondsDelta := intervalDelta.Seconds()
 
 	// Wait group to handle the various threads
 	wg := new(sync.WaitGroup)
-	wg.Add(2)
+	wg.Add(3)
 
 	// Run task loop
 	go func() {
@@ -123,92 +141,93 @@ func run(c *cli.Context) error {
 			randomSeconds := rand.Intn(int(secondsDelta))
 			interval := time.Duration(randomSeconds)*time.Second + minTasksInterval
 
-			// Check the EC status
-			err := services.WaitEthClientSynced(c, false) // Force refresh the primary / fallback EC status
-			if err != nil {
+			// Check the EC and BC status and the node's trusted status
+			if err := health.run(); err != nil {
 				errorLog.Println(err)
 			} else {
-				// Check the BC status
-				err := services.WaitBeaconClientSynced(c, false) // Force refresh the primary / fallback BC status
-				if err != nil {
+				// Run the manual rewards tree generation
+				if err := generateRewardsTree.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the challenge check
+				if err := respondChallenges.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the rewards tree submission check
+				if err := submitRewardsTree.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the price submission check
+				if err := submitRplPrice.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the network balance submission check
+				if err := submitNetworkBalances.run(); err != nil {
 					errorLog.Println(err)
-				} else {
-					// Run the manual rewards tree generation
-					if err := generateRewardsTree.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the challenge check
-					if err := respondChallenges.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the rewards tree submission check
-					if err := submitRewardsTree.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the price submission check
-					if err := submitRplPrice.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the network balance submission check
-					if err := submitNetworkBalances.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the withdrawable status submission check
-					if err := submitWithdrawableMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the minipool dissolve check
-					if err := dissolveTimedOutMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the withdrawal processing check
-					if err := processWithdrawals.run(); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-
-					// Run the minipool scrub check
-					if err := submitScrubMinipools.run(); err != nil {
-						errorLog.Println(err)
-					}
-					/*time.Sleep(taskCooldown)
-
-					// Run the fee recipient penalty check
-					if err := processPenalties.run(); err != nil {
-						errorLog.Println(err)
-					}*/
-					// DISABLED until MEV-Boost can support it
 				}
+				time.Sleep(taskCooldown)
+
+				// Run the withdrawable status submission check
+				if err := submitWithdrawableMinipools.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the minipool dissolve check
+				if err := dissolveTimedOutMinipools.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the withdrawal processing check
+				if err := processWithdrawals.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the minipool scrub check
+				if err := submitScrubMinipools.run(); err != nil {
+					errorLog.Println(err)
+				}
+				/*time.Sleep(taskCooldown)
+
+				// Run the fee recipient penalty check
+				if err := processPenalties.run(); err != nil {
+					errorLog.Println(err)
+				}*/
+				// DISABLED until MEV-Boost can support it
+
+				heartbeat.taskLoopFinished(time.Now())
 			}
 			time.Sleep(interval)
 		}
 		wg.Done()
 	}()
 
+	// Run heartbeat loop
+	go func() {
+		heartbeat.run()
+		wg.Done()
+	}()
+
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector)
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector, heartbeatCollector, health)
 		if err != nil {
 			errorLog.Println(err)
 		}
 		wg.Done()
 	}()
 
-	// Wait for both threads to stop
+	// Wait for all threads to stop
 	wg.Wait()
 	return nil
 }
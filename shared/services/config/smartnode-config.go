@@ -77,6 +77,12 @@ type SmartnodeConfig struct {
 	// Token for Oracle DAO members to use when uploading Merkle trees to Web3.Storage
 	Web3StorageApiToken config.Parameter `yaml:"web3StorageApiToken,omitempty"`
 
+	// Maximum validator balance the watchtower will submit for a withdrawable minipool
+	MaxMinipoolWithdrawableBalance config.Parameter `yaml:"maxMinipoolWithdrawableBalance,omitempty"`
+
+	// Interval, in seconds, between watchtower heartbeats
+	WatchtowerHeartbeatInterval config.Parameter `yaml:"watchtowerHeartbeatInterval,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -271,6 +277,30 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade:   false,
 		},
 
+		MaxMinipoolWithdrawableBalance: config.Parameter{
+			ID:                   "maxMinipoolWithdrawableBalance",
+			Name:                 "Max Withdrawable Balance",
+			Description:          "[orange]**For Oracle DAO members only.**\n\n[white]The largest validator balance (in ETH) the watchtower will accept when submitting a minipool's withdrawable status. Balances above this are treated as bad data from the Beacon Node and will not be submitted.",
+			Type:                 config.ParameterType_Float,
+			Default:              map[config.Network]interface{}{config.Network_All: float64(40)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
+		WatchtowerHeartbeatInterval: config.Parameter{
+			ID:                   "watchtowerHeartbeatInterval",
+			Name:                 "Watchtower Heartbeat Interval",
+			Description:          "How often (in seconds) the watchtower logs a heartbeat and updates its last heartbeat metric, so you can tell an idle watchtower from one that has stopped responding.\n\nUse 0 to disable the heartbeat.",
+			Type:                 config.ParameterType_Uint,
+			Default:              map[config.Network]interface{}{config.Network_All: uint64(60)},
+			AffectsContainers:    []config.ContainerID{config.ContainerID_Watchtower},
+			EnvironmentVariables: []string{},
+			CanBeBlank:           false,
+			OverwriteOnUpgrade:   false,
+		},
+
 		txWatchUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://etherscan.io/tx",
 			config.Network_Prater:  "https://goerli.etherscan.io/tx",
@@ -407,6 +437,8 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.RewardsTreeMode,
 		&cfg.ArchiveECUrl,
 		&cfg.Web3StorageApiToken,
+		&cfg.MaxMinipoolWithdrawableBalance,
+		&cfg.WatchtowerHeartbeatInterval,
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 )
 
+// The amount fields are nil when Error is set
 type FaucetStatusResponse struct {
 	Status             string   `json:"status"`
 	Error              string   `json:"error"`
@@ -15,6 +16,7 @@ type FaucetStatusResponse struct {
 	WithdrawableAmount *big.Int `json:"withdrawableAmount"`
 	WithdrawalFee      *big.Int `json:"withdrawalFee"`
 	ResetsInBlocks     uint64   `json:"resetsInBlocks"`
+	ResetsInSeconds    uint64   `json:"resetsInSeconds"`
 }
 
 type CanFaucetWithdrawRplResponse struct {
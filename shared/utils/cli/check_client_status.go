@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
@@ -9,6 +11,16 @@ import (
 
 // Check the status of the Execution and Consensus client(s) and provision the API with them
 func CheckClientStatus(rp *rocketpool.Client) error {
+	return checkClientStatusImpl(rp, os.Stdout)
+}
+
+// Check the status of the Execution and Consensus client(s) and provision the API with them,
+// printing any notices to stderr so stdout only contains the command's output
+func CheckClientStatusToStderr(rp *rocketpool.Client) error {
+	return checkClientStatusImpl(rp, os.Stderr)
+}
+
+func checkClientStatusImpl(rp *rocketpool.Client, out io.Writer) error {
 
 	// Check if the primary clients are up, synced, and able to respond to requests - if not, forces the use of the fallbacks for this command
 	response, err := rp.GetClientStatus()
@@ -36,7 +48,7 @@ func CheckClientStatus(rp *rocketpool.Client) error {
 
 		// Fallback EC and CC are good
 		if ecMgrStatus.FallbackClientStatus.IsSynced && bcMgrStatus.FallbackClientStatus.IsSynced {
-			fmt.Printf("%sNOTE: primary clients are not ready, using fallback clients...\n\tPrimary EC status: %s\n\tPrimary CC status: %s%s\n\n", colorYellow, primaryEcStatus, primaryBcStatus, colorReset)
+			fmt.Fprintf(out, "%sNOTE: primary clients are not ready, using fallback clients...\n\tPrimary EC status: %s\n\tPrimary CC status: %s%s\n\n", colorYellow, primaryEcStatus, primaryBcStatus, colorReset)
 			rp.SetClientStatusFlags(true, true)
 			return nil
 		}
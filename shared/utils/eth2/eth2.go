@@ -30,6 +30,16 @@ func EpochAt(config beacon.Eth2Config, time uint64) uint64 {
 	return config.GenesisEpoch + (time-config.GenesisTime)/config.SecondsPerEpoch
 }
 
+// Convert a beacon chain balance in gwei to ETH
+func GweiToEth(gwei uint64) float64 {
+	var gweiFloat big.Float
+	var ethFloat big.Float
+	gweiFloat.SetUint64(gwei)
+	ethFloat.Quo(&gweiFloat, big.NewFloat(eth.WeiPerGwei))
+	eth64, _ := ethFloat.Float64()
+	return eth64
+}
+
 // Get the balances of the minipools on the beacon chain
 func GetBeaconBalances(rp *rocketpool.RocketPool, bc beacon.Client, addresses []common.Address, beaconHead beacon.BeaconHead, opts *bind.CallOpts) ([]minipoolBalanceDetails, error) {
 
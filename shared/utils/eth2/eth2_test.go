@@ -0,0 +1,55 @@
+package eth2
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGweiToEth(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		gwei     uint64
+		expected float64
+	}{
+		{
+			name:     "zero",
+			gwei:     0,
+			expected: 0,
+		},
+		{
+			name:     "1 gwei",
+			gwei:     1,
+			expected: 0.000000001,
+		},
+		{
+			name:     "32 ETH",
+			gwei:     32000000000,
+			expected: 32,
+		},
+		{
+			name:     "32 ETH - 1 gwei",
+			gwei:     31999999999,
+			expected: 31.999999999,
+		},
+		{
+			name:     "40 ETH + 1 gwei",
+			gwei:     40000000001,
+			expected: 40.000000001,
+		},
+		{
+			name:     "max uint64",
+			gwei:     math.MaxUint64,
+			expected: 18446744073.709551615,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if eth := GweiToEth(test.gwei); eth != test.expected {
+				t.Errorf("Expected %d gwei to be %.9f ETH, got %.9f", test.gwei, test.expected, eth)
+			}
+		})
+	}
+
+}
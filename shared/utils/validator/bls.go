@@ -1,7 +1,14 @@
 package validator
 
-// BLS signing root with domain
-type signingRoot struct {
-	ObjectRoot []byte `ssz-size:"32"`
-	Domain     []byte `ssz-size:"32"`
+import (
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+)
+
+// Get the signing root for an object root and signature domain
+func ComputeSigningRoot(objectRoot [32]byte, domain []byte) ([32]byte, error) {
+	sr := eth2.SigningRoot{
+		ObjectRoot: objectRoot[:],
+		Domain:     domain,
+	}
+	return sr.HashTreeRoot()
 }
@@ -0,0 +1,68 @@
+package validator
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestComputeSigningRoot(t *testing.T) {
+
+	// Expected signing roots are sha256(object_root || domain), per compute_signing_root in the consensus spec
+	tests := []struct {
+		name                string
+		objectRoot          string
+		domain              string
+		expectedSigningRoot string
+	}{
+		{
+			name:                "mainnet deposit domain",
+			objectRoot:          "0000000000000000000000000000000000000000000000000000000000000000",
+			domain:              "03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9",
+			expectedSigningRoot: "2f46075466459957818ee33458b0cff1430dac652dd572cf80ba9a8358de2378",
+		},
+		{
+			name:                "mainnet voluntary exit domain",
+			objectRoot:          "0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20",
+			domain:              "04000000b5303f2ad2010d699a76c8e62350947421a3e4a979779642cfdb0f66",
+			expectedSigningRoot: "cae4d12ce902a7810ef95ba566074305d5d82264502d09d4d5fa9971477e2c88",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			var objectRoot [32]byte
+			objectRootBytes, err := hex.DecodeString(test.objectRoot)
+			if err != nil {
+				t.Fatal(err)
+			}
+			copy(objectRoot[:], objectRootBytes)
+			domain, err := hex.DecodeString(test.domain)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Get the signing root
+			signingRoot, err := ComputeSigningRoot(objectRoot, domain)
+			if err != nil {
+				t.Fatalf("Error computing signing root: %s", err)
+			}
+			if hex.EncodeToString(signingRoot[:]) != test.expectedSigningRoot {
+				t.Errorf("Expected signing root %s, got %x", test.expectedSigningRoot, signingRoot)
+			}
+
+		})
+	}
+
+}
+
+func TestComputeSigningRootInvalidDomain(t *testing.T) {
+
+	// Domains must be exactly 32 bytes
+	for _, length := range []int{0, 4, 31, 33} {
+		if _, err := ComputeSigningRoot([32]byte{}, make([]byte, length)); err == nil {
+			t.Errorf("Expected an error for a %d byte domain", length)
+		}
+	}
+
+}
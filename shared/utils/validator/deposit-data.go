@@ -27,13 +27,14 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 		return eth2.DepositData{}, common.Hash{}, err
 	}
 
-	sr := eth2.SigningRoot{
-		ObjectRoot: or[:],
-		Domain:     eth2types.Domain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot),
+	// Get deposit domain
+	depositDomain, err := GetDepositDomain(eth2Config)
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
 	}
 
 	// Get signing root with domain
-	srHash, err := sr.HashTreeRoot()
+	srHash, err := ComputeSigningRoot(or, depositDomain)
 	if err != nil {
 		return eth2.DepositData{}, common.Hash{}, err
 	}
@@ -56,3 +57,8 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 	return depositData, depositDataRoot, nil
 
 }
+
+// Get the deposit signature domain for the network described by the given eth2 config
+func GetDepositDomain(eth2Config beacon.Eth2Config) ([]byte, error) {
+	return eth2types.ComputeDomain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot)
+}
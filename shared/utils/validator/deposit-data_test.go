@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func TestGetDepositDomain(t *testing.T) {
+
+	tests := []struct {
+		name               string
+		genesisForkVersion string
+		expectedDomain     string
+	}{
+		{
+			name:               "mainnet",
+			genesisForkVersion: "00000000",
+			expectedDomain:     "03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9",
+		},
+		{
+			name:               "prater",
+			genesisForkVersion: "00001020",
+			expectedDomain:     "03000000e4be9393b074ca1f3e4aabd585ca4bea101170ccfaf71b89ce5c5c38",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			forkVersion, err := hex.DecodeString(test.genesisForkVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectedDomain, err := hex.DecodeString(test.expectedDomain)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Get the deposit domain
+			domain, err := GetDepositDomain(beacon.Eth2Config{GenesisForkVersion: forkVersion})
+			if err != nil {
+				t.Fatalf("Error getting deposit domain: %s", err)
+			}
+			if !bytes.Equal(domain, expectedDomain) {
+				t.Errorf("Expected deposit domain %x, got %x", expectedDomain, domain)
+			}
+
+		})
+	}
+
+}
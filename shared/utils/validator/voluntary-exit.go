@@ -22,12 +22,7 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 	}
 
 	// Get signing root
-	sr := eth2.SigningRoot{
-		ObjectRoot: or[:],
-		Domain:     signatureDomain,
-	}
-
-	srHash, err := sr.HashTreeRoot()
+	srHash, err := ComputeSigningRoot(or, signatureDomain)
 	if err != nil {
 		return types.ValidatorSignature{}, err
 	}